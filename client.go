@@ -7,12 +7,16 @@ import (
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -20,17 +24,64 @@ const (
 	DefaultEndpoint = "https://infogr.am/service/v1"
 )
 
+// Doer performs an *http.Request and returns its *http.Response, the same signature as *http.Client.Do. Implement it to inject middleware (retries, logging, rate limiting, tracing) around the requests a Client makes, or to fake them out in tests; see the fakeclient subpackage.
+type Doer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
 // Client is used to interact with the Infogram API
 type Client struct {
 	setup sync.Once
 
-	HTTPClient *http.Client
+	HTTPClient Doer
 	Endpoint   string
 	APIKey     string
 	APISecret  string
+
+	// RetryPolicy, when set, retries idempotent (GET/DELETE) requests that fail with a retryable status code. Nil disables retries.
+	RetryPolicy *RetryPolicy
+	// Limiter, when set, is waited on before every request to cap outbound QPS.
+	Limiter Limiter
+	// Observer, when set, is notified after every retried attempt.
+	Observer Observer
+}
+
+// NewRequest builds an *http.Request against the Client's Endpoint. qs is encoded as the URL query string if non-nil. body is encoded as the request body: url.Values is form-encoded with a matching Content-Type, anything else is marshalled as JSON, and nil leaves the body empty.
+func (c *Client) NewRequest(method string, path string, qs url.Values, body interface{}) (*http.Request, error) {
+	var buf io.Reader
+	var contentType string
+
+	switch b := body.(type) {
+	case nil:
+	case url.Values:
+		buf = strings.NewReader(b.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("encoding request body: %w", err)
+		}
+		buf = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", c.Endpoint, path), buf)
+	if err != nil {
+		return nil, fmt.Errorf("new %s request: %w", method, err)
+	}
+
+	if qs != nil {
+		req.URL.RawQuery = qs.Encode()
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	return req, nil
 }
 
-// Do performs the *http.Request and decodes the http.Response.Body into v and return the *http.Response. If v is an io.Writer it will copy the body to the writer.
+// Do performs the *http.Request and decodes the http.Response.Body into v and return the *http.Response. If v is an io.Writer it will copy the body to the writer. GET and DELETE requests are retried according to c.RetryPolicy when set.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
 	c.setup.Do(func() {
 		if c.HTTPClient == nil {
@@ -42,6 +93,61 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*htt
 		}
 	})
 
+	retryable := c.RetryPolicy != nil && (req.Method == http.MethodGet || req.Method == http.MethodDelete)
+	attempts := 1
+	if retryable {
+		attempts = c.RetryPolicy.maxAttempts()
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if c.Limiter != nil {
+			if err := c.Limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := c.do(ctx, req, v)
+		if err == nil {
+			return res, nil
+		}
+		lastErr = err
+
+		if !retryable || attempt == attempts {
+			return nil, err
+		}
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || !c.RetryPolicy.isRetryable(apiErr.StatusCode) {
+			return nil, err
+		}
+
+		delay := c.RetryPolicy.delay(attempt, apiErr.RetryAfter)
+		if c.Observer != nil {
+			c.Observer.ObserveRetry(req, attempt, apiErr.StatusCode, err, delay)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return nil, lastErr
+}
+
+// do performs a single attempt of req, applying c.RetryPolicy's AttemptTimeout if set.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+	if c.RetryPolicy != nil && c.RetryPolicy.AttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.RetryPolicy.AttemptTimeout)
+		defer cancel()
+	}
+
+	req = req.WithContext(ctx)
 	req.RequestURI = ""
 
 	res, err := c.HTTPClient.Do(req)
@@ -50,17 +156,18 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*htt
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		default:
+			return nil, err
 		}
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode > 299 {
-		_, err := io.ReadAll(res.Body)
+		body, err := io.ReadAll(res.Body)
 		if err != nil {
 			return nil, err
 		}
 
-		return nil, fmt.Errorf("code: %d, message: ", res.StatusCode)
+		return nil, newAPIError(req, res, body)
 	}
 
 	if v != nil {
@@ -88,7 +195,9 @@ func (c *Client) SignRequest(req *http.Request) error {
 	case http.MethodGet, http.MethodDelete:
 		data = req.URL.Query()
 	default:
-		req.ParseForm()
+		if err := req.ParseForm(); err != nil {
+			return fmt.Errorf("parsing form: %w", err)
+		}
 		data = req.Form
 	}
 
@@ -128,15 +237,18 @@ func (c *Client) SignRequest(req *http.Request) error {
 	case http.MethodGet, http.MethodDelete:
 		req.URL.RawQuery = data.Encode()
 	default:
-		req.Form = data
+		encoded := data.Encode()
+		req.Body = io.NopCloser(strings.NewReader(encoded))
+		req.ContentLength = int64(len(encoded))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	}
 
 	return nil
 }
 
 // Infographics fetches the list of infographics
-func (c *Client) Infographics() ([]Infographic, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", c.Endpoint, "infographics"), nil)
+func (c *Client) Infographics(ctx context.Context) ([]Infographic, error) {
+	req, err := c.NewRequest(http.MethodGet, "/infographics", nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("new infographics request: %w", err)
 	}
@@ -147,7 +259,7 @@ func (c *Client) Infographics() ([]Infographic, error) {
 	}
 
 	var infographics []Infographic
-	_, err = c.Do(context.Background(), req, &infographics)
+	_, err = c.Do(ctx, req, &infographics)
 	if err != nil {
 		return nil, err
 	}
@@ -155,9 +267,9 @@ func (c *Client) Infographics() ([]Infographic, error) {
 	return infographics, nil
 }
 
-// Infographics fetches a single infographic by identification number
-func (c *Client) Infographic(id string) (*Infographic, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s", c.Endpoint, "infographics", id), nil)
+// Infographic fetches a single infographic by identification number
+func (c *Client) Infographic(ctx context.Context, id int) (*Infographic, error) {
+	req, err := c.NewRequest(http.MethodGet, fmt.Sprintf("/infographics/%d", id), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("new infographic request: %w", err)
 	}
@@ -168,17 +280,17 @@ func (c *Client) Infographic(id string) (*Infographic, error) {
 	}
 
 	var infographic Infographic
-	_, err = c.Do(context.Background(), req, &infographic)
+	_, err = c.Do(ctx, req, &infographic)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 
 	return &infographic, nil
 }
 
 // UserInfographics fetches the list of infographics for the user's identification number
-func (c *Client) UserInfographics(id string) ([]Infographic, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s", c.Endpoint, "users", id, "infographics"), nil)
+func (c *Client) UserInfographics(ctx context.Context, id string) ([]Infographic, error) {
+	req, err := c.NewRequest(http.MethodGet, fmt.Sprintf("/users/%s/infographics", id), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("new user infographics request: %w", err)
 	}
@@ -189,17 +301,17 @@ func (c *Client) UserInfographics(id string) ([]Infographic, error) {
 	}
 
 	var infographics []Infographic
-	_, err = c.Do(context.Background(), req, &infographics)
+	_, err = c.Do(ctx, req, &infographics)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 
 	return infographics, nil
 }
 
-// Infographics fetches a available themes to use for infographics
-func (c *Client) Themes() ([]Theme, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", c.Endpoint, "themes"), nil)
+// Themes fetches a available themes to use for infographics
+func (c *Client) Themes(ctx context.Context) ([]Theme, error) {
+	req, err := c.NewRequest(http.MethodGet, "/themes", nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("new themes request: %w", err)
 	}
@@ -210,10 +322,85 @@ func (c *Client) Themes() ([]Theme, error) {
 	}
 
 	var themes []Theme
-	_, err = c.Do(context.Background(), req, &themes)
+	_, err = c.Do(ctx, req, &themes)
 	if err != nil {
 		return nil, err
 	}
 
 	return themes, nil
 }
+
+// infographicForm builds the form-encoded body shared by CreateInfographic and UpdateInfographic
+func infographicForm(content string, themeId int, title string, publish bool, password string) url.Values {
+	form := url.Values{}
+	form.Set("content", content)
+	form.Set("theme_id", strconv.Itoa(themeId))
+	form.Set("title", title)
+	form.Set("publish", strconv.FormatBool(publish))
+	if password != "" {
+		form.Set("password", password)
+	}
+	return form
+}
+
+// CreateInfographic creates a new infographic from content rendered against themeId and returns the created Infographic
+func (c *Client) CreateInfographic(ctx context.Context, content string, themeId int, title string, publish bool, password string) (*Infographic, error) {
+	req, err := c.NewRequest(http.MethodPost, "/infographics", nil, infographicForm(content, themeId, title, publish, password))
+	if err != nil {
+		return nil, fmt.Errorf("new create infographic request: %w", err)
+	}
+
+	err = c.SignRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var infographic Infographic
+	_, err = c.Do(ctx, req, &infographic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &infographic, nil
+}
+
+// UpdateInfographic replaces the content, theme, and publish state of the infographic identified by id and returns the updated Infographic
+func (c *Client) UpdateInfographic(ctx context.Context, id int, content string, themeId int, title string, publish bool, password string) (*Infographic, error) {
+	req, err := c.NewRequest(http.MethodPut, fmt.Sprintf("/infographics/%d", id), nil, infographicForm(content, themeId, title, publish, password))
+	if err != nil {
+		return nil, fmt.Errorf("new update infographic request: %w", err)
+	}
+
+	err = c.SignRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var infographic Infographic
+	_, err = c.Do(ctx, req, &infographic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &infographic, nil
+}
+
+// DeleteInfographic deletes the infographic identified by id
+func (c *Client) DeleteInfographic(ctx context.Context, id int) error {
+	req, err := c.NewRequest(http.MethodDelete, fmt.Sprintf("/infographics/%d", id), nil, nil)
+	if err != nil {
+		return fmt.Errorf("new delete infographic request: %w", err)
+	}
+
+	err = c.SignRequest(req)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -85,7 +86,7 @@ func TestClient(t *testing.T) {
 		}
 
 		c.Assert(req.URL.Query().Get("api_key"), quicktest.Equals, client.APIKey)
-		c.Assert(req.URL.Query().Get("api_sig"), quicktest.Equals, "474554262f736572766963652f76312f696e666f6772617068696373266170695f6b6579253344746573742d6b65792532366964253344312532366c6162656c2533446e65772532426c6162656c253236a6b812acfa12a677bb2fe6b266bac6a7294d9d06")
+		c.Assert(req.URL.Query().Get("api_sig"), quicktest.Equals, "MI1mo8MxXf6dUz9YBWduvYzb8t4=")
 	})
 
 	c.Run("Do", func(c *quicktest.C) {
@@ -99,7 +100,12 @@ func TestClient(t *testing.T) {
 
 			res, err := client.Do(context.Background(), req, nil)
 			c.Assert(res, quicktest.IsNil)
-			c.Assert(err, quicktest.ErrorMatches, "404 page not found\n")
+
+			var apiErr *infogram.APIError
+			c.Assert(errors.As(err, &apiErr), quicktest.IsTrue)
+			c.Assert(apiErr.StatusCode, quicktest.Equals, http.StatusNotFound)
+			c.Assert(string(apiErr.Body), quicktest.Equals, "404 page not found\n")
+			c.Assert(errors.Is(err, infogram.ErrNotFound), quicktest.IsTrue)
 		})
 
 		c.Run("encode to writer", func(c *quicktest.C) {
@@ -164,7 +170,7 @@ func TestAPI(t *testing.T) {
 
 			client := infogram.Client{HTTPClient: server.Client(), Endpoint: server.URL}
 
-			data, err := client.Infographics()
+			data, err := client.Infographics(context.Background())
 			c.Assert(err, quicktest.IsNil)
 			c.Assert(data, quicktest.DeepEquals, infographics)
 		})
@@ -187,7 +193,7 @@ func TestAPI(t *testing.T) {
 
 			client := infogram.Client{HTTPClient: server.Client(), Endpoint: server.URL}
 
-			data, err := client.Infographic(1)
+			data, err := client.Infographic(context.Background(), 1)
 			c.Assert(err, quicktest.IsNil)
 			c.Assert(data, quicktest.DeepEquals, &infographic)
 		})
@@ -212,7 +218,7 @@ func TestAPI(t *testing.T) {
 
 			client := infogram.Client{HTTPClient: server.Client(), Endpoint: server.URL}
 
-			data, err := client.UserInfographics("12345")
+			data, err := client.UserInfographics(context.Background(), "12345")
 			c.Assert(err, quicktest.IsNil)
 			c.Assert(data, quicktest.DeepEquals, infographics)
 		})
@@ -234,9 +240,92 @@ func TestAPI(t *testing.T) {
 
 			client := infogram.Client{HTTPClient: server.Client(), Endpoint: server.URL}
 
-			data, err := client.Themes()
+			data, err := client.Themes(context.Background())
 			c.Assert(err, quicktest.IsNil)
 			c.Assert(data, quicktest.DeepEquals, themes)
 		})
+
+		c.Run("CreateInfographic", func(c *quicktest.C) {
+			infographic := infogram.Infographic{
+				Id:        1,
+				Title:     "Number One",
+				Thumbnail: &url.URL{Host: "example.com", Path: "/1.png"},
+				ThemeId:   99,
+				Published: true,
+				URL:       &url.URL{Host: "example.com", Path: "/1"},
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				c.Assert(r.Method, quicktest.Equals, http.MethodPost)
+
+				err := r.ParseForm()
+				c.Assert(err, quicktest.IsNil)
+				c.Assert(r.Form.Get("content"), quicktest.Equals, "<p>hi</p>")
+				c.Assert(r.Form.Get("theme_id"), quicktest.Equals, "99")
+				c.Assert(r.Form.Get("title"), quicktest.Equals, "Number One")
+				c.Assert(r.Form.Get("publish"), quicktest.Equals, "true")
+				c.Assert(r.Form.Get("api_sig"), quicktest.Not(quicktest.Equals), "")
+
+				rw.WriteHeader(http.StatusOK)
+				json.NewEncoder(rw).Encode(&infographic)
+			}))
+			defer server.Close()
+
+			client := infogram.Client{HTTPClient: server.Client(), Endpoint: server.URL, APIKey: "test-key", APISecret: "shh"}
+
+			data, err := client.CreateInfographic(context.Background(), "<p>hi</p>", 99, "Number One", true, "")
+			c.Assert(err, quicktest.IsNil)
+			c.Assert(data, quicktest.DeepEquals, &infographic)
+		})
+
+		c.Run("UpdateInfographic", func(c *quicktest.C) {
+			infographic := infogram.Infographic{
+				Id:        1,
+				Title:     "Updated",
+				Thumbnail: &url.URL{Host: "example.com", Path: "/1.png"},
+				ThemeId:   100,
+				Published: false,
+				URL:       &url.URL{Host: "example.com", Path: "/1"},
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				c.Assert(r.Method, quicktest.Equals, http.MethodPut)
+				c.Assert(r.URL.Path, quicktest.Equals, "/infographics/1")
+
+				err := r.ParseForm()
+				c.Assert(err, quicktest.IsNil)
+				c.Assert(r.Form.Get("content"), quicktest.Equals, "<p>updated</p>")
+				c.Assert(r.Form.Get("theme_id"), quicktest.Equals, "100")
+				c.Assert(r.Form.Get("title"), quicktest.Equals, "Updated")
+				c.Assert(r.Form.Get("publish"), quicktest.Equals, "false")
+				c.Assert(r.Form.Get("api_sig"), quicktest.Not(quicktest.Equals), "")
+
+				rw.WriteHeader(http.StatusOK)
+				json.NewEncoder(rw).Encode(&infographic)
+			}))
+			defer server.Close()
+
+			client := infogram.Client{HTTPClient: server.Client(), Endpoint: server.URL, APIKey: "test-key", APISecret: "shh"}
+
+			data, err := client.UpdateInfographic(context.Background(), 1, "<p>updated</p>", 100, "Updated", false, "")
+			c.Assert(err, quicktest.IsNil)
+			c.Assert(data, quicktest.DeepEquals, &infographic)
+		})
+
+		c.Run("DeleteInfographic", func(c *quicktest.C) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				c.Assert(r.Method, quicktest.Equals, http.MethodDelete)
+				c.Assert(r.URL.Path, quicktest.Equals, "/infographics/1")
+				c.Assert(r.URL.Query().Get("api_sig"), quicktest.Not(quicktest.Equals), "")
+
+				rw.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := infogram.Client{HTTPClient: server.Client(), Endpoint: server.URL, APIKey: "test-key", APISecret: "shh"}
+
+			err := client.DeleteInfographic(context.Background(), 1)
+			c.Assert(err, quicktest.IsNil)
+		})
 	})
 }
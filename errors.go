@@ -0,0 +1,91 @@
+package infogram
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Sentinel errors returned by Client.Do for well-known failure classes. APIError.Unwrap matches one of these by StatusCode, so callers can branch with errors.Is instead of comparing StatusCode themselves.
+var (
+	ErrUnauthorized = errors.New("infogram: unauthorized")
+	ErrNotFound     = errors.New("infogram: not found")
+	ErrRateLimited  = errors.New("infogram: rate limited")
+)
+
+// APIError is returned by Client.Do for any non-2xx response. It carries the request and response details needed to debug a failure instead of discarding the response body.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Body       []byte
+	Message    string
+	Code       string
+
+	// RetryAfter is the duration the server asked the caller to wait before retrying, parsed from the Retry-After header. It is zero when the header was absent or unparsable.
+	RetryAfter time.Duration
+}
+
+// Error implements error
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s %s: %d %s", e.Method, e.URL, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("%s %s: %d", e.Method, e.URL, e.StatusCode)
+}
+
+// Unwrap lets errors.Is/errors.As match e against ErrUnauthorized, ErrNotFound, or ErrRateLimited by StatusCode.
+func (e *APIError) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	default:
+		return nil
+	}
+}
+
+// apiErrorBody is the subset of an Infogram JSON error response APIError understands.
+type apiErrorBody struct {
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
+
+// newAPIError builds an APIError for req's non-2xx res, parsing body as an Infogram JSON error and the Retry-After header when present.
+func newAPIError(req *http.Request, res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		Body:       body,
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After")),
+	}
+
+	var parsed apiErrorBody
+	if json.Unmarshal(body, &parsed) == nil {
+		apiErr.Message = parsed.Message
+		apiErr.Code = parsed.Code
+	}
+
+	return apiErr
+}
+
+// parseRetryAfter parses a Retry-After header value, which Infogram sends as a number of seconds, into a time.Duration. It returns zero if value is empty or not a valid integer.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
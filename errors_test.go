@@ -0,0 +1,45 @@
+package infogram_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/blainsmith/infogram-go"
+	"github.com/frankban/quicktest"
+)
+
+func TestAPIError(t *testing.T) {
+	c := quicktest.New(t)
+
+	c.Run("Error", func(c *quicktest.C) {
+		c.Run("with message", func(c *quicktest.C) {
+			err := &infogram.APIError{Method: http.MethodGet, URL: "http://example.com/infographics/1", StatusCode: http.StatusNotFound, Message: "not found"}
+			c.Assert(err.Error(), quicktest.Equals, "GET http://example.com/infographics/1: 404 not found")
+		})
+
+		c.Run("without message", func(c *quicktest.C) {
+			err := &infogram.APIError{Method: http.MethodGet, URL: "http://example.com/infographics/1", StatusCode: http.StatusInternalServerError}
+			c.Assert(err.Error(), quicktest.Equals, "GET http://example.com/infographics/1: 500")
+		})
+	})
+
+	c.Run("Unwrap", func(c *quicktest.C) {
+		cases := []struct {
+			statusCode int
+			target     error
+		}{
+			{http.StatusUnauthorized, infogram.ErrUnauthorized},
+			{http.StatusForbidden, infogram.ErrUnauthorized},
+			{http.StatusNotFound, infogram.ErrNotFound},
+			{http.StatusTooManyRequests, infogram.ErrRateLimited},
+		}
+
+		for _, tc := range cases {
+			err := &infogram.APIError{StatusCode: tc.statusCode}
+			c.Assert(errors.Is(err, tc.target), quicktest.IsTrue)
+		}
+
+		c.Assert(errors.Is(&infogram.APIError{StatusCode: http.StatusBadRequest}, infogram.ErrNotFound), quicktest.IsFalse)
+	})
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -8,25 +9,27 @@ import (
 )
 
 func main() {
+	ctx := context.Background()
+
 	client := infogram.Client{
 		Endpoint:  infogram.DefaultEndpoint,
 		APIKey:    "VoyBH3SykNCgqcWD9CybuPxwVGFToUJ3",
 		APISecret: "qUoyG18UrkC0XGbgf7vOfhVy7ddcXWTw",
 	}
 
-	themes, err := client.Themes()
+	themes, err := client.Themes(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
 	fmt.Println(themes)
 
-	infographics, err := client.Infographics()
+	infographics, err := client.Infographics(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
 	fmt.Println(infographics)
 
-	infographic, err := client.Infographic(infographics[0].Id)
+	infographic, err := client.Infographic(ctx, infographics[0].Id)
 	if err != nil {
 		log.Fatal(err)
 	}
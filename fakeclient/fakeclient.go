@@ -0,0 +1,33 @@
+// Package fakeclient implements infogram.Doer for use in tests, recording the
+// last request it received and replaying canned responses from an
+// http.Handler so callers can exercise code built around infogram.Client
+// without spinning up an httptest.Server.
+package fakeclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+)
+
+// Client records the last *http.Request passed to Do and replays Handler's
+// response through an httptest.ResponseRecorder.
+type Client struct {
+	Handler http.Handler
+
+	LastRequest *http.Request
+}
+
+// New returns a Client that replays handler's response for every request.
+func New(handler http.Handler) *Client {
+	return &Client{Handler: handler}
+}
+
+// Do implements infogram.Doer by recording req and invoking Handler with an httptest.ResponseRecorder.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	c.LastRequest = req
+
+	rec := httptest.NewRecorder()
+	c.Handler.ServeHTTP(rec, req)
+
+	return rec.Result(), nil
+}
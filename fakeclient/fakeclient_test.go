@@ -0,0 +1,30 @@
+package fakeclient_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/blainsmith/infogram-go"
+	"github.com/blainsmith/infogram-go/fakeclient"
+	"github.com/frankban/quicktest"
+)
+
+func TestClient(t *testing.T) {
+	c := quicktest.New(t)
+
+	c.Run("records the last request and replays the handler's response", func(c *quicktest.C) {
+		fake := fakeclient.New(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(`[]`))
+		}))
+
+		client := infogram.Client{HTTPClient: fake, Endpoint: infogram.DefaultEndpoint}
+
+		_, err := client.Infographics(context.Background())
+		c.Assert(err, quicktest.IsNil)
+
+		c.Assert(fake.LastRequest, quicktest.IsNotNil)
+		c.Assert(fake.LastRequest.URL.Path, quicktest.Equals, "/service/v1/infographics")
+	})
+}
@@ -0,0 +1,41 @@
+package rendercache
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// Disk is an infogram.RenderCache backed by files in Dir, one per key.
+type Disk struct {
+	Dir string
+}
+
+// NewDisk returns a Disk cache rooted at dir. dir is not created until the first Set.
+func NewDisk(dir string) *Disk {
+	return &Disk{Dir: dir}
+}
+
+// Get implements infogram.RenderCache.
+func (d *Disk) Get(key string) ([]byte, bool) {
+	data, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	return data, true
+}
+
+// Set implements infogram.RenderCache. Errors writing to Dir are discarded; a failed Set simply leaves the key uncached.
+func (d *Disk) Set(key string, data []byte) {
+	if err := os.MkdirAll(d.Dir, 0o755); err != nil {
+		return
+	}
+
+	os.WriteFile(d.path(key), data, 0o644)
+}
+
+// path maps a cache key to a file within Dir, escaping it so colons in the key don't collide with path separators.
+func (d *Disk) path(key string) string {
+	return filepath.Join(d.Dir, url.QueryEscape(key))
+}
@@ -0,0 +1,25 @@
+package rendercache_test
+
+import (
+	"testing"
+
+	"github.com/blainsmith/infogram-go/rendercache"
+	"github.com/frankban/quicktest"
+)
+
+func TestDisk(t *testing.T) {
+	c := quicktest.New(t)
+
+	c.Run("Get/Set", func(c *quicktest.C) {
+		cache := rendercache.NewDisk(c.Mkdir())
+
+		_, found := cache.Get("missing")
+		c.Assert(found, quicktest.IsFalse)
+
+		cache.Set("1:pdf:100", []byte("data"))
+
+		data, found := cache.Get("1:pdf:100")
+		c.Assert(found, quicktest.IsTrue)
+		c.Assert(data, quicktest.DeepEquals, []byte("data"))
+	})
+}
@@ -0,0 +1,72 @@
+// Package rendercache provides infogram.RenderCache backends for caching
+// rendered Infographic output.
+package rendercache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DefaultMaxEntries is the entry cap NewMemory applies when constructed with maxEntries <= 0.
+const DefaultMaxEntries = 128
+
+// Memory is a fixed-size, in-memory infogram.RenderCache that evicts the least recently used entry once MaxEntries is reached. It is safe for concurrent use.
+type Memory struct {
+	MaxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // front is most recently used
+}
+
+type memoryEntry struct {
+	key  string
+	data []byte
+}
+
+// NewMemory returns an empty Memory cache that holds at most maxEntries entries. A maxEntries <= 0 uses DefaultMaxEntries.
+func NewMemory(maxEntries int) *Memory {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+
+	return &Memory{
+		MaxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Get implements infogram.RenderCache, marking key as most recently used on a hit.
+func (m *Memory) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, found := m.entries[key]
+	if !found {
+		return nil, false
+	}
+
+	m.order.MoveToFront(elem)
+	return elem.Value.(*memoryEntry).data, true
+}
+
+// Set implements infogram.RenderCache, evicting the least recently used entry if adding data for key would exceed MaxEntries.
+func (m *Memory) Set(key string, data []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, found := m.entries[key]; found {
+		elem.Value.(*memoryEntry).data = data
+		m.order.MoveToFront(elem)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryEntry{key: key, data: data})
+
+	if m.order.Len() > m.MaxEntries {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryEntry).key)
+	}
+}
@@ -0,0 +1,47 @@
+package rendercache_test
+
+import (
+	"testing"
+
+	"github.com/blainsmith/infogram-go/rendercache"
+	"github.com/frankban/quicktest"
+)
+
+func TestMemory(t *testing.T) {
+	c := quicktest.New(t)
+
+	c.Run("Get/Set", func(c *quicktest.C) {
+		cache := rendercache.NewMemory(0)
+
+		_, found := cache.Get("missing")
+		c.Assert(found, quicktest.IsFalse)
+
+		cache.Set("key", []byte("data"))
+
+		data, found := cache.Get("key")
+		c.Assert(found, quicktest.IsTrue)
+		c.Assert(data, quicktest.DeepEquals, []byte("data"))
+	})
+
+	c.Run("evicts the least recently used entry once MaxEntries is exceeded", func(c *quicktest.C) {
+		cache := rendercache.NewMemory(2)
+
+		cache.Set("a", []byte("1"))
+		cache.Set("b", []byte("2"))
+
+		// touch "a" so "b" becomes the least recently used entry
+		_, found := cache.Get("a")
+		c.Assert(found, quicktest.IsTrue)
+
+		cache.Set("c", []byte("3"))
+
+		_, found = cache.Get("b")
+		c.Assert(found, quicktest.IsFalse)
+
+		_, found = cache.Get("a")
+		c.Assert(found, quicktest.IsTrue)
+
+		_, found = cache.Get("c")
+		c.Assert(found, quicktest.IsTrue)
+	})
+}
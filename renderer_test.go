@@ -0,0 +1,70 @@
+package infogram_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/blainsmith/infogram-go"
+	"github.com/frankban/quicktest"
+)
+
+type countingCache struct {
+	data map[string][]byte
+	gets int
+	sets int
+}
+
+func (c *countingCache) Get(key string) ([]byte, bool) {
+	c.gets++
+	data, found := c.data[key]
+	return data, found
+}
+
+func (c *countingCache) Set(key string, data []byte) {
+	c.sets++
+	c.data[key] = data
+}
+
+func TestRenderer(t *testing.T) {
+	c := quicktest.New(t)
+
+	c.Run("Render", func(c *quicktest.C) {
+		c.Run("unsupported format", func(c *quicktest.C) {
+			renderer := infogram.Renderer{Client: &infogram.Client{}}
+
+			_, err := renderer.Render(context.Background(), &infogram.Infographic{Id: 1}, infogram.RenderOptions{Format: "svg"})
+			c.Assert(err, quicktest.ErrorMatches, `unsupported render format: "svg"`)
+		})
+
+		c.Run("caches rendered output by infographic, format, and modified time", func(c *quicktest.C) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				requests++
+				rw.WriteHeader(http.StatusOK)
+				rw.Write([]byte("%PDF-1.4"))
+			}))
+			defer server.Close()
+
+			cache := &countingCache{data: make(map[string][]byte)}
+			renderer := infogram.Renderer{
+				Client: &infogram.Client{HTTPClient: server.Client(), Endpoint: server.URL},
+				Cache:  cache,
+			}
+
+			infographic := &infogram.Infographic{Id: 1, Modified: time.Unix(1700000000, 0).UTC()}
+			opts := infogram.RenderOptions{Format: "pdf"}
+
+			reader, err := renderer.Render(context.Background(), infographic, opts)
+			c.Assert(err, quicktest.IsNil)
+			c.Assert(reader.ContentType(), quicktest.Equals, "application/pdf")
+			c.Assert(requests, quicktest.Equals, 1)
+
+			_, err = renderer.Render(context.Background(), infographic, opts)
+			c.Assert(err, quicktest.IsNil)
+			c.Assert(requests, quicktest.Equals, 1)
+		})
+	})
+}
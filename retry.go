@@ -0,0 +1,126 @@
+package infogram
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RetryPolicy controls how Client.Do retries idempotent (GET/DELETE) requests that fail with a retryable status code. A nil RetryPolicy on Client disables retries entirely.
+type RetryPolicy struct {
+	MaxAttempts int // total attempts including the first; values below 1 are treated as 1
+
+	BaseDelay time.Duration // backoff delay before the first retry; zero defaults to 100ms
+	MaxDelay  time.Duration // upper bound on the backoff delay before jitter; zero means no cap
+
+	// RetryableStatus reports whether a status code should be retried. nil uses DefaultRetryableStatus.
+	RetryableStatus func(statusCode int) bool
+
+	AttemptTimeout time.Duration // per-attempt timeout applied via context.WithTimeout; zero means no per-attempt deadline
+}
+
+// DefaultRetryableStatus reports whether statusCode should be retried: 429 or any 5xx.
+func DefaultRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// isRetryable reports whether statusCode should trigger a retry under p.
+func (p *RetryPolicy) isRetryable(statusCode int) bool {
+	if p.RetryableStatus != nil {
+		return p.RetryableStatus(statusCode)
+	}
+	return DefaultRetryableStatus(statusCode)
+}
+
+// maxAttempts returns p.MaxAttempts normalized to at least 1.
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// delay returns how long to wait before the given retry attempt (1-indexed). It honors retryAfter when positive, otherwise backs off exponentially from BaseDelay with full jitter, capped at MaxDelay.
+func (p *RetryPolicy) delay(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	base := p.BaseDelay
+	if base <= 0 {
+		base = 100 * time.Millisecond
+	}
+
+	backoff := time.Duration(float64(base) * math.Pow(2, float64(attempt-1)))
+	if p.MaxDelay > 0 && backoff > p.MaxDelay {
+		backoff = p.MaxDelay
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// Limiter caps the rate of outbound requests made through Client.Do. Wait blocks until a request is permitted to proceed or ctx is done.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is a Limiter that permits up to Rate requests per Interval, refilling one token every Interval/Rate so bursts are smoothed rather than all granted at once. Rate and Interval must both be positive.
+type TokenBucket struct {
+	Rate     int
+	Interval time.Duration
+
+	once    sync.Once
+	tokens  chan struct{}
+	initErr error
+}
+
+func (t *TokenBucket) init() {
+	if t.Rate <= 0 || t.Interval <= 0 {
+		t.initErr = fmt.Errorf("infogram: TokenBucket requires a positive Rate and Interval, got Rate=%d Interval=%s", t.Rate, t.Interval)
+		return
+	}
+
+	t.tokens = make(chan struct{}, t.Rate)
+	for i := 0; i < t.Rate; i++ {
+		t.tokens <- struct{}{}
+	}
+
+	go func() {
+		ticker := time.NewTicker(t.Interval / time.Duration(t.Rate))
+		defer ticker.Stop()
+		for range ticker.C {
+			select {
+			case t.tokens <- struct{}{}:
+			default: // bucket is full, drop the tick
+			}
+		}
+	}()
+}
+
+// Wait implements Limiter. It returns an error without blocking if Rate or Interval is non-positive.
+func (t *TokenBucket) Wait(ctx context.Context) error {
+	t.once.Do(t.init)
+
+	if t.initErr != nil {
+		return t.initErr
+	}
+
+	select {
+	case <-t.tokens:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Observer is notified after each retried attempt so retry behavior can be surfaced through Prometheus, OTel, or logs.
+type Observer interface {
+	ObserveRetry(req *http.Request, attempt int, statusCode int, err error, delay time.Duration)
+}
@@ -0,0 +1,83 @@
+package infogram_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/blainsmith/infogram-go"
+	"github.com/frankban/quicktest"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	c := quicktest.New(t)
+
+	c.Run("Do retries GET on a retryable status and succeeds", func(c *quicktest.C) {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts < 3 {
+				rw.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+
+		client := infogram.Client{
+			HTTPClient:  server.Client(),
+			Endpoint:    server.URL,
+			RetryPolicy: &infogram.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+		}
+
+		req := httptest.NewRequest(http.MethodGet, server.URL, nil)
+
+		_, err := client.Do(context.Background(), req, nil)
+		c.Assert(err, quicktest.IsNil)
+		c.Assert(attempts, quicktest.Equals, 3)
+	})
+
+	c.Run("Do does not retry a non-idempotent method", func(c *quicktest.C) {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			attempts++
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := infogram.Client{
+			HTTPClient:  server.Client(),
+			Endpoint:    server.URL,
+			RetryPolicy: &infogram.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+		}
+
+		req := httptest.NewRequest(http.MethodPost, server.URL, nil)
+
+		_, err := client.Do(context.Background(), req, nil)
+		c.Assert(err, quicktest.IsNotNil)
+		c.Assert(attempts, quicktest.Equals, 1)
+	})
+}
+
+func TestTokenBucket(t *testing.T) {
+	c := quicktest.New(t)
+
+	c.Run("Wait blocks once the bucket is empty", func(c *quicktest.C) {
+		bucket := &infogram.TokenBucket{Rate: 1, Interval: time.Hour}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		c.Assert(bucket.Wait(ctx), quicktest.IsNil)
+
+		cancel()
+		c.Assert(bucket.Wait(ctx), quicktest.Equals, context.Canceled)
+	})
+
+	c.Run("Wait returns an error instead of panicking on a non-positive Rate or Interval", func(c *quicktest.C) {
+		c.Assert((&infogram.TokenBucket{}).Wait(context.Background()), quicktest.IsNotNil)
+		c.Assert((&infogram.TokenBucket{Rate: 1}).Wait(context.Background()), quicktest.IsNotNil)
+		c.Assert((&infogram.TokenBucket{Interval: time.Second}).Wait(context.Background()), quicktest.IsNotNil)
+	})
+}
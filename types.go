@@ -1,12 +1,9 @@
 package infogram
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"net/url"
 	"time"
 )
@@ -22,40 +19,6 @@ type Infographic struct {
 	URL       *url.URL
 }
 
-func (i *Infographic) reader(client *Client, format string) (io.Reader, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s/%d?api_key=%s&format=%s", client.Endpoint, "infographics", i.Id, client.APIKey, format), nil)
-	if err != nil {
-		return nil, fmt.Errorf("new infographic PDF reader request: %w", err)
-	}
-
-	err = client.SignRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := client.Do(context.Background(), req, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return res.Body, nil
-}
-
-// PDFReader returns an io.Reader of the Infographic in PDF format
-func (i *Infographic) PDFReader(client *Client) (io.Reader, error) {
-	return i.reader(client, "pdf")
-}
-
-// PNGReader returns an io.Reader of the Infographic in PNG format
-func (i *Infographic) PNGReader(client *Client) (io.Reader, error) {
-	return i.reader(client, "png")
-}
-
-// HTMLReader returns an io.Reader of the Infographic in HTML format
-func (i *Infographic) HTMLReader(client *Client) (io.Reader, error) {
-	return i.reader(client, "html")
-}
-
 // MarshalJSON implements json.Marshaler
 func (i *Infographic) MarshalJSON() ([]byte, error) {
 	data := make(map[string]interface{})